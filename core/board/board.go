@@ -13,23 +13,62 @@ import (
 // Board Contains the board, capturesStones, and ko
 // ko contains a point that is illegal to recapture due to Ko.
 type Board struct {
-	board [][]color.Color
-	ko    *point.Point
+	board   [][]color.Color
+	ko      *point.Point
+	ruleset RulesetOptions
+
+	// zobrist and hash incrementally track the current position so
+	// PlaceStone can detect superko in O(1) amortized time.
+	zobrist   [][][3]uint64
+	hash      uint64
+	positions map[uint64]struct{}
+
+	subscribers []chan<- BoardEvent
+
+	// captures tracks prisoners taken by each color over the life of
+	// the board, for rulesets whose scoring counts them.
+	captures map[color.Color]int
 }
 
-// NewBoard creates a new size x size board.
+// NewBoard creates a new size x size board, enforcing SimpleKo.
 func NewBoard(size int) *Board {
+	return NewBoardWithRuleset(size, RulesetOptions{KoRule: SimpleKo})
+}
+
+// NewBoardWithRuleset creates a new size x size board that enforces
+// the given ruleset's ko rule, suicide policy, and scoring.
+func NewBoardWithRuleset(size int, ruleset RulesetOptions) *Board {
 	board := Board{
-		make([][]color.Color, size),
-		nil,
+		board:     make([][]color.Color, size),
+		ko:        nil,
+		ruleset:   ruleset,
+		zobrist:   newZobristTable(size),
+		positions: make(map[uint64]struct{}),
+		captures:  make(map[color.Color]int),
 	}
 
 	for i := 0; i < size; i++ {
 		board.board[i] = make([]color.Color, size)
 	}
+	board.recordPosition()
 	return &board
 }
 
+// Ruleset returns the ruleset this board enforces.
+func (b *Board) Ruleset() RulesetOptions {
+	return b.ruleset
+}
+
+// SetKomi updates the komi this board's ruleset applies in Score.
+func (b *Board) SetKomi(komi float64) {
+	b.ruleset.Komi = komi
+}
+
+// Captures returns the number of prisoners color c has taken so far.
+func (b *Board) Captures(c color.Color) int {
+	return b.captures[c]
+}
+
 // PlaceStone adds a stone to the board
 // and removes captured stones (if any).
 // returns the captured stones, or err
@@ -39,31 +78,96 @@ func (b *Board) PlaceStone(m *move.Move) ([]*point.Point, error) {
 	b.ko = nil
 
 	if !b.inBounds(m.Point()) {
-		return nil, fmt.Errorf("move %v out of bounds for %dx%d board",
-			m.Point(), len(b.board[0]), len(b.board))
+		return nil, b.reject(m.Point(), m.Color(), &ErrOutOfBounds{Point: m.Point(), Size: len(b.board)})
 	}
 	if b.colorAt(m.Point()) != color.Empty {
-		return nil, fmt.Errorf("move %v already occupied", m.Point())
+		return nil, b.reject(m.Point(), m.Color(), &ErrOccupied{Point: m.Point()})
 	}
 
 	b.setColor(m)
 	capturedStones := b.findCapturedGroups(m)
-	if len(capturedStones) == 0 && len(b.capturedStones(m.Point())) != 0 {
-		b.setColor(move.NewMove(color.Empty, m.Point()))
-		return nil, fmt.Errorf("move %v is suicidal", m.Point())
+
+	isSuicide := false
+	if len(capturedStones) == 0 {
+		if ownGroup := b.capturedStones(m.Point()); len(ownGroup) != 0 {
+			if !b.ruleset.AllowSuicide {
+				b.setColor(move.NewMove(color.Empty, m.Point()))
+				return nil, b.reject(m.Point(), m.Color(), &ErrSuicide{Point: m.Point()})
+			}
+			isSuicide = true
+			capturedStones = ownGroup
+		}
 	}
-	if len(capturedStones) == 1 {
+
+	if !isSuicide && len(capturedStones) == 1 {
 		b.ko = m.Point()
 		if ko != nil && *ko == *(capturedStones[0]) {
 			b.setColor(move.NewMove(color.Empty, m.Point()))
-			return nil, fmt.Errorf("%v is an illegal ko move", m.Point())
+			return nil, b.reject(m.Point(), m.Color(), &ErrKo{Point: m.Point()})
+		}
+	}
+
+	// Superko applies equally to suicide moves: a self-capture still
+	// produces a position, and that position must be checked against
+	// and recorded in b.positions like any other.
+	var resultingHash uint64
+	checkSuperko := b.ruleset.KoRule != SimpleKo
+	if checkSuperko {
+		resultingHash = b.hashAfterCaptures(capturedStones)
+		if b.ruleset.KoRule == SituationalSuperko {
+			resultingHash ^= sideToMoveSalt[colorIndex(oppositeColor(m.Color()))]
+		}
+		if _, seen := b.positions[resultingHash]; seen {
+			b.setColor(move.NewMove(color.Empty, m.Point()))
+			return nil, b.reject(m.Point(), m.Color(), &ErrSuperko{Point: m.Point()})
 		}
 	}
 
 	b.removeCapturedStones(capturedStones)
+	if checkSuperko {
+		b.positions[resultingHash] = struct{}{}
+	}
+	if isSuicide {
+		b.captures[oppositeColor(m.Color())] += len(capturedStones)
+	} else {
+		b.captures[m.Color()] += len(capturedStones)
+	}
+
+	b.publish(BoardEvent{Type: StonePlaced, Point: m.Point(), Color: m.Color()})
+	if len(capturedStones) > 0 {
+		b.publish(BoardEvent{Type: StonesCaptured, Captured: capturedStones})
+	}
+	if b.ko != nil {
+		b.publish(BoardEvent{Type: KoSet, Point: b.ko})
+	}
 	return capturedStones, nil
 }
 
+// reject publishes a MoveRejected event for violation and returns it,
+// so callers both get a typed error and subscribers see the rejection.
+func (b *Board) reject(pt *point.Point, c color.Color, violation MoveViolation) MoveViolation {
+	b.publish(BoardEvent{Type: MoveRejected, Point: pt, Color: c, Reason: violation.Reason()})
+	return violation
+}
+
+// hashAfterCaptures returns the Zobrist hash the board would have once
+// capturedStones are removed, without mutating the board.
+func (b *Board) hashAfterCaptures(capturedStones []*point.Point) uint64 {
+	h := b.hash
+	seen := make(map[point.Point]bool, len(capturedStones))
+	for _, pt := range capturedStones {
+		if seen[*pt] {
+			continue
+		}
+		seen[*pt] = true
+		x, y := int(pt.X()), int(pt.Y())
+		c := b.colorAt(pt)
+		h ^= b.zobrist[y][x][colorIndex(c)]
+		h ^= b.zobrist[y][x][colorIndex(color.Empty)]
+	}
+	return h
+}
+
 // findCapturedGroups returns the groups captured by *Move m.
 func (b *Board) findCapturedGroups(m *move.Move) []*point.Point {
 	pt := m.Point()
@@ -142,10 +246,32 @@ func (b *Board) colorAt(pt *point.Point) color.Color {
 	return b.board[y][x]
 }
 
-// setColor sets the color m.Color at point m.Point.
+// setColor sets the color m.Color at point m.Point, keeping the
+// incrementally maintained Zobrist hash in sync.
 func (b *Board) setColor(m *move.Move) {
 	var x, y int = int(m.Point().X()), int(m.Point().Y())
+	b.hash ^= b.zobrist[y][x][colorIndex(b.board[y][x])]
 	b.board[y][x] = m.Color()
+	b.hash ^= b.zobrist[y][x][colorIndex(m.Color())]
+}
+
+// recordPosition stores the current hash as a position that has
+// occurred in the game. Under SituationalSuperko the hash is salted
+// with Black as the side to move.
+func (b *Board) recordPosition() {
+	h := b.hash
+	if b.ruleset.KoRule == SituationalSuperko {
+		h ^= sideToMoveSalt[colorIndex(color.Black)]
+	}
+	b.positions[h] = struct{}{}
+}
+
+// oppositeColor returns the other player's color.
+func oppositeColor(c color.Color) color.Color {
+	if c == color.Black {
+		return color.White
+	}
+	return color.Black
 }
 
 // getNeighbors returns a list of points neighboring point pt.
@@ -179,15 +305,15 @@ func (b *Board) GetFullBoardState() []*move.Move {
 // String returns a string representation of this board.
 // For example:
 //
-//    b.Board {{B, W, B,  },
-//             {W,  , B, B},
-//             { ,  , W,  },
-//             {B,  , W,  }}
+//	b.Board {{B, W, B,  },
+//	         {W,  , B, B},
+//	         { ,  , W,  },
+//	         {B,  , W,  }}
 //
-//    Becomes  [B W B .]
-//             [W . B B]
-//             [. . W .]
-//             [B . W .]
+//	Becomes  [B W B .]
+//	         [W . B B]
+//	         [. . W .]
+//	         [B . W .]
 func (b *Board) String() string {
 	var sb strings.Builder
 	for i := 0; i < len(b.board); i++ {
@@ -0,0 +1,258 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/otrego/clamshell/core/color"
+	"github.com/otrego/clamshell/core/move"
+	"github.com/otrego/clamshell/core/point"
+)
+
+// newTestBoard builds a board of the given size and ruleset with
+// stones placed directly on the grid (bypassing PlaceStone legality),
+// then recomputes the Zobrist hash from scratch and records it as the
+// board's initial position. This lets tests set up positions that
+// would be painful or impossible to reach move by move.
+func newTestBoard(size int, ruleset RulesetOptions, stones map[point.Point]color.Color) *Board {
+	b := NewBoardWithRuleset(size, ruleset)
+	for pt, c := range stones {
+		b.board[pt.Y()][pt.X()] = c
+	}
+
+	b.hash = 0
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			b.hash ^= b.zobrist[y][x][colorIndex(b.board[y][x])]
+		}
+	}
+	b.positions = make(map[uint64]struct{})
+	b.recordPosition()
+	return b
+}
+
+func TestPlaceStone_OutOfBounds(t *testing.T) {
+	b := NewBoard(9)
+	_, err := b.PlaceStone(move.NewMove(color.Black, point.New(9, 0)))
+	if _, ok := err.(*ErrOutOfBounds); !ok {
+		t.Fatalf("expected *ErrOutOfBounds, got %v (%T)", err, err)
+	}
+}
+
+func TestPlaceStone_Occupied(t *testing.T) {
+	b := NewBoard(9)
+	if _, err := b.PlaceStone(move.NewMove(color.Black, point.New(4, 4))); err != nil {
+		t.Fatalf("unexpected error on first move: %v", err)
+	}
+	_, err := b.PlaceStone(move.NewMove(color.White, point.New(4, 4)))
+	if _, ok := err.(*ErrOccupied); !ok {
+		t.Fatalf("expected *ErrOccupied, got %v (%T)", err, err)
+	}
+}
+
+func TestPlaceStone_Suicide(t *testing.T) {
+	// White stones surround (1,1) on all sides.
+	stones := map[point.Point]color.Color{
+		*point.New(1, 0): color.White,
+		*point.New(0, 1): color.White,
+		*point.New(2, 1): color.White,
+		*point.New(1, 2): color.White,
+	}
+	b := newTestBoard(9, RulesetOptions{KoRule: SimpleKo}, stones)
+
+	_, err := b.PlaceStone(move.NewMove(color.Black, point.New(1, 1)))
+	if _, ok := err.(*ErrSuicide); !ok {
+		t.Fatalf("expected *ErrSuicide, got %v (%T)", err, err)
+	}
+}
+
+func TestPlaceStone_AllowSuicide(t *testing.T) {
+	stones := map[point.Point]color.Color{
+		*point.New(1, 0): color.White,
+		*point.New(0, 1): color.White,
+		*point.New(2, 1): color.White,
+		*point.New(1, 2): color.White,
+	}
+	b := newTestBoard(9, RulesetOptions{KoRule: SimpleKo, AllowSuicide: true}, stones)
+
+	captured, err := b.PlaceStone(move.NewMove(color.Black, point.New(1, 1)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(captured) != 1 || *captured[0] != *point.New(1, 1) {
+		t.Fatalf("expected the suicided stone at (1,1) to come back as captured, got %v", captured)
+	}
+	if b.Captures(color.White) != 1 {
+		t.Fatalf("expected White to be credited with 1 capture, got %d", b.Captures(color.White))
+	}
+}
+
+func TestPlaceStone_SimpleKo(t *testing.T) {
+	// Classic single-stone ko:
+	//   . B W .
+	//   B W . W
+	//   . B W .
+	stones := map[point.Point]color.Color{
+		*point.New(1, 0): color.Black,
+		*point.New(2, 0): color.White,
+		*point.New(0, 1): color.Black,
+		*point.New(1, 1): color.White,
+		*point.New(3, 1): color.White,
+		*point.New(1, 2): color.Black,
+		*point.New(2, 2): color.White,
+	}
+	b := newTestBoard(9, RulesetOptions{KoRule: SimpleKo}, stones)
+
+	if _, err := b.PlaceStone(move.NewMove(color.Black, point.New(2, 1))); err != nil {
+		t.Fatalf("black capture: %v", err)
+	}
+
+	_, err := b.PlaceStone(move.NewMove(color.White, point.New(1, 1)))
+	if _, ok := err.(*ErrKo); !ok {
+		t.Fatalf("expected *ErrKo, got %v (%T)", err, err)
+	}
+}
+
+// TestSuperko_TripleKo builds three independent single-stone ko
+// shapes far enough apart not to interact, then cycles through
+// capturing each one in turn: Black takes ko0, White takes ko1, Black
+// takes ko2, then White retakes ko0, Black retakes ko1, White retakes
+// ko2. Every one of those six moves is legal under SimpleKo, because
+// Board.ko only ever remembers the single most recent capture point
+// -- but the position after the sixth move is identical to the board
+// before the first move, which PositionalSuperko must reject.
+func TestSuperko_TripleKo(t *testing.T) {
+	// addKoDiamond places a ko shape at column offset ox: attacker
+	// stones ring a single defender stone, leaving one attack point
+	// where attacker can capture the defender.
+	addKoDiamond := func(stones map[point.Point]color.Color, ox int64, attacker color.Color) {
+		defender := oppositeColor(attacker)
+		stones[*point.New(ox+1, 0)] = attacker
+		stones[*point.New(ox+0, 1)] = attacker
+		stones[*point.New(ox+1, 2)] = attacker
+		stones[*point.New(ox+2, 0)] = defender
+		stones[*point.New(ox+3, 1)] = defender
+		stones[*point.New(ox+2, 2)] = defender
+		stones[*point.New(ox+1, 1)] = defender
+		// (ox+2, 1) is left empty: the attack/recapture point.
+	}
+
+	buildBoard := func(ruleset RulesetOptions) *Board {
+		stones := make(map[point.Point]color.Color)
+		addKoDiamond(stones, 0, color.Black)
+		addKoDiamond(stones, 5, color.White)
+		addKoDiamond(stones, 10, color.Black)
+		return newTestBoard(14, ruleset, stones)
+	}
+
+	play := func(t *testing.T, b *Board, c color.Color, x, y int64) {
+		t.Helper()
+		if _, err := b.PlaceStone(move.NewMove(c, point.New(x, y))); err != nil {
+			t.Fatalf("%v playing (%d,%d): %v", c, x, y, err)
+		}
+	}
+
+	t.Run("SimpleKo allows the full cycle", func(t *testing.T) {
+		b := buildBoard(RulesetOptions{KoRule: SimpleKo})
+		play(t, b, color.Black, 2, 1)  // take ko0
+		play(t, b, color.White, 7, 1)  // take ko1
+		play(t, b, color.Black, 12, 1) // take ko2
+		play(t, b, color.White, 1, 1)  // retake ko0
+		play(t, b, color.Black, 6, 1)  // retake ko1
+		play(t, b, color.White, 11, 1) // retake ko2: recreates the original position
+
+		before := buildBoard(RulesetOptions{KoRule: SimpleKo})
+		if b.String() != before.String() {
+			t.Fatalf("expected the board to have returned to its original position:\ngot:\n%s\nwant:\n%s",
+				b.String(), before.String())
+		}
+	})
+
+	t.Run("PositionalSuperko rejects the repeat", func(t *testing.T) {
+		b := buildBoard(RulesetOptions{KoRule: PositionalSuperko})
+		play(t, b, color.Black, 2, 1)  // take ko0
+		play(t, b, color.White, 7, 1)  // take ko1
+		play(t, b, color.Black, 12, 1) // take ko2
+		play(t, b, color.White, 1, 1)  // retake ko0
+		play(t, b, color.Black, 6, 1)  // retake ko1
+
+		_, err := b.PlaceStone(move.NewMove(color.White, point.New(11, 1))) // retake ko2
+		if _, ok := err.(*ErrSuperko); !ok {
+			t.Fatalf("expected *ErrSuperko recreating the original position, got %v (%T)", err, err)
+		}
+	})
+}
+
+// TestSuperko_SendTwoReturnOne builds a shape where Black captures a
+// two-stone White group in a single move -- a capture Board.ko never
+// tracks, since simple ko only remembers single-stone captures. White
+// then recaptures the lone resulting Black stone (legal: Board.ko was
+// never set) and replays its second stone to fully restore the
+// original position. Every move is legal under SimpleKo, yet the
+// final position is an exact repeat, which is exactly the class of
+// repetition "send two, return one" exploits to get around the
+// simple-ko rule, and which PositionalSuperko must catch.
+func TestSuperko_SendTwoReturnOne(t *testing.T) {
+	buildBoard := func(ruleset RulesetOptions) *Board {
+		stones := map[point.Point]color.Color{
+			// Black ring, surrounding a two-stone White group with a
+			// single shared liberty at (3,1).
+			*point.New(1, 0): color.Black,
+			*point.New(2, 0): color.Black,
+			*point.New(0, 1): color.Black,
+			*point.New(1, 2): color.Black,
+			*point.New(2, 2): color.Black,
+			// White center pair, in atari together at (3,1).
+			*point.New(1, 1): color.White,
+			*point.New(2, 1): color.White,
+			// White flank, so that after Black captures the pair by
+			// playing (3,1), Black's own stone is left with exactly
+			// one liberty (the vacated (2,1)).
+			*point.New(3, 0): color.White,
+			*point.New(3, 2): color.White,
+			*point.New(4, 1): color.White,
+		}
+		return newTestBoard(5, ruleset, stones)
+	}
+
+	play := func(t *testing.T, b *Board, c color.Color, x, y int64) {
+		t.Helper()
+		if _, err := b.PlaceStone(move.NewMove(c, point.New(x, y))); err != nil {
+			t.Fatalf("%v playing (%d,%d): %v", c, x, y, err)
+		}
+	}
+
+	t.Run("SimpleKo allows the full cycle", func(t *testing.T) {
+		b := buildBoard(RulesetOptions{KoRule: SimpleKo})
+
+		captured, err := b.PlaceStone(move.NewMove(color.Black, point.New(3, 1)))
+		if err != nil {
+			t.Fatalf("black capturing the pair: %v", err)
+		}
+		if len(captured) != 2 {
+			t.Fatalf("expected a two-stone capture, got %d stones", len(captured))
+		}
+
+		play(t, b, color.White, 2, 1) // recapture Black's lone stone
+		play(t, b, color.White, 1, 1) // replay the second stone, restoring the pair
+
+		before := buildBoard(RulesetOptions{KoRule: SimpleKo})
+		if b.String() != before.String() {
+			t.Fatalf("expected the board to have returned to its original position:\ngot:\n%s\nwant:\n%s",
+				b.String(), before.String())
+		}
+	})
+
+	t.Run("PositionalSuperko rejects the repeat", func(t *testing.T) {
+		b := buildBoard(RulesetOptions{KoRule: PositionalSuperko})
+
+		if _, err := b.PlaceStone(move.NewMove(color.Black, point.New(3, 1))); err != nil {
+			t.Fatalf("black capturing the pair: %v", err)
+		}
+		play(t, b, color.White, 2, 1) // recapture Black's lone stone
+
+		_, err := b.PlaceStone(move.NewMove(color.White, point.New(1, 1))) // restore the pair
+		if _, ok := err.(*ErrSuperko); !ok {
+			t.Fatalf("expected *ErrSuperko recreating the original position, got %v (%T)", err, err)
+		}
+	})
+}
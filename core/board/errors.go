@@ -0,0 +1,86 @@
+package board
+
+import (
+	"fmt"
+
+	"github.com/otrego/clamshell/core/point"
+)
+
+// MoveViolation is the interface implemented by every error PlaceStone
+// can return, so callers can distinguish violations by Reason() rather
+// than by string-matching Error().
+type MoveViolation interface {
+	error
+	// Reason returns a short, machine-readable identifier for the kind
+	// of violation, e.g. "ko" or "suicide".
+	Reason() string
+}
+
+// ErrOutOfBounds is returned when a move's point is outside the board.
+type ErrOutOfBounds struct {
+	Point *point.Point
+	Size  int
+}
+
+// Error implements the error interface.
+func (e *ErrOutOfBounds) Error() string {
+	return fmt.Sprintf("move %v out of bounds for %dx%d board", e.Point, e.Size, e.Size)
+}
+
+// Reason implements MoveViolation.
+func (e *ErrOutOfBounds) Reason() string { return "outOfBounds" }
+
+// ErrOccupied is returned when a move's point already has a stone.
+type ErrOccupied struct {
+	Point *point.Point
+}
+
+// Error implements the error interface.
+func (e *ErrOccupied) Error() string {
+	return fmt.Sprintf("move %v already occupied", e.Point)
+}
+
+// Reason implements MoveViolation.
+func (e *ErrOccupied) Reason() string { return "occupied" }
+
+// ErrSuicide is returned when a move would leave its own group with no
+// liberties without capturing anything.
+type ErrSuicide struct {
+	Point *point.Point
+}
+
+// Error implements the error interface.
+func (e *ErrSuicide) Error() string {
+	return fmt.Sprintf("move %v is suicidal", e.Point)
+}
+
+// Reason implements MoveViolation.
+func (e *ErrSuicide) Reason() string { return "suicide" }
+
+// ErrKo is returned when a move would immediately recapture a single
+// stone that was itself the result of a single-stone capture.
+type ErrKo struct {
+	Point *point.Point
+}
+
+// Error implements the error interface.
+func (e *ErrKo) Error() string {
+	return fmt.Sprintf("%v is an illegal ko move", e.Point)
+}
+
+// Reason implements MoveViolation.
+func (e *ErrKo) Reason() string { return "ko" }
+
+// ErrSuperko is returned when a move would recreate a board position
+// that has already occurred earlier in the game.
+type ErrSuperko struct {
+	Point *point.Point
+}
+
+// Error implements the error interface.
+func (e *ErrSuperko) Error() string {
+	return fmt.Sprintf("move %v recreates a previous board position (superko)", e.Point)
+}
+
+// Reason implements MoveViolation.
+func (e *ErrSuperko) Reason() string { return "superko" }
@@ -0,0 +1,58 @@
+package board
+
+import (
+	"github.com/otrego/clamshell/core/color"
+	"github.com/otrego/clamshell/core/point"
+)
+
+// EventType identifies the kind of BoardEvent.
+type EventType int
+
+// The events a Board can emit.
+const (
+	// StonePlaced is emitted when a stone is legally placed.
+	StonePlaced EventType = iota
+	// StonesCaptured is emitted when placing a stone captures one or
+	// more opposing stones.
+	StonesCaptured
+	// MoveRejected is emitted when PlaceStone refuses a move.
+	MoveRejected
+	// KoSet is emitted when a move sets a new simple-ko restriction.
+	KoSet
+)
+
+// BoardEvent describes something that happened on a Board, for
+// subscribers driving a UI or a network protocol off of Board state.
+type BoardEvent struct {
+	Type EventType
+
+	// Point is the point the triggering move was played at. Set for
+	// StonePlaced, MoveRejected, and KoSet.
+	Point *point.Point
+	// Color is the color of the triggering move. Set for StonePlaced
+	// and MoveRejected.
+	Color color.Color
+	// Captured holds the stones removed from the board. Set for
+	// StonesCaptured.
+	Captured []*point.Point
+	// Reason is the MoveViolation.Reason() of the rejected move. Set
+	// for MoveRejected.
+	Reason string
+}
+
+// Subscribe registers ch to receive BoardEvents as they happen. Sends
+// are non-blocking: a subscriber that isn't keeping up misses events
+// rather than stalling PlaceStone.
+func (b *Board) Subscribe(ch chan<- BoardEvent) {
+	b.subscribers = append(b.subscribers, ch)
+}
+
+// publish sends e to every subscriber.
+func (b *Board) publish(e BoardEvent) {
+	for _, ch := range b.subscribers {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
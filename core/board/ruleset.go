@@ -0,0 +1,92 @@
+package board
+
+// KoRule selects how a Board guards against recreating previous
+// board positions.
+type KoRule int
+
+const (
+	// SimpleKo only forbids immediately recapturing a single stone
+	// that was itself the result of a single-stone capture.
+	SimpleKo KoRule = iota
+	// PositionalSuperko forbids any move that recreates a stone
+	// arrangement that has occurred earlier in the game, regardless
+	// of whose turn it was.
+	PositionalSuperko
+	// SituationalSuperko forbids any move that recreates a stone
+	// arrangement together with the same side to move.
+	SituationalSuperko
+)
+
+// ScoringMethod selects how Board.Score tallies a finished game.
+type ScoringMethod int
+
+const (
+	// TerritoryScoring counts surrounded empty points plus prisoners,
+	// as in Japanese rules.
+	TerritoryScoring ScoringMethod = iota
+	// AreaScoring counts surrounded empty points plus living stones on
+	// the board, as in Chinese rules.
+	AreaScoring
+	// AGAScoring counts surrounded empty points, living stones, and
+	// prisoners, as in AGA rules.
+	AGAScoring
+)
+
+// RulesetOptions configures the rules a Board enforces: whether
+// suicide is legal, which ko rule applies, and how Score computes the
+// result. The zero value selects SimpleKo with suicide disallowed and
+// territory scoring, matching the Board's historical behavior.
+type RulesetOptions struct {
+	// Name is a human-readable label, e.g. "Japanese".
+	Name string
+	// KoRule determines which repeated-position rule is enforced.
+	KoRule KoRule
+	// AllowSuicide permits placing a stone that leaves its own group
+	// with no liberties; the group is then removed instead of the
+	// move being rejected. New Zealand rules allow this.
+	AllowSuicide bool
+	// ScoringMethod determines how Score tallies the result.
+	ScoringMethod ScoringMethod
+	// Komi is the compensation added to White's score.
+	Komi float64
+}
+
+// JapaneseRuleset scores by territory, forbids suicide, and only
+// enforces SimpleKo.
+var JapaneseRuleset = RulesetOptions{
+	Name:          "Japanese",
+	KoRule:        SimpleKo,
+	AllowSuicide:  false,
+	ScoringMethod: TerritoryScoring,
+	Komi:          6.5,
+}
+
+// ChineseRuleset scores by area, forbids suicide, and enforces
+// positional superko.
+var ChineseRuleset = RulesetOptions{
+	Name:          "Chinese",
+	KoRule:        PositionalSuperko,
+	AllowSuicide:  false,
+	ScoringMethod: AreaScoring,
+	Komi:          7.5,
+}
+
+// AGARuleset scores by area plus prisoners, forbids suicide, and
+// enforces situational superko.
+var AGARuleset = RulesetOptions{
+	Name:          "AGA",
+	KoRule:        SituationalSuperko,
+	AllowSuicide:  false,
+	ScoringMethod: AGAScoring,
+	Komi:          7.5,
+}
+
+// NewZealandRuleset scores by area, allows suicide, and enforces
+// situational superko.
+var NewZealandRuleset = RulesetOptions{
+	Name:          "New Zealand",
+	KoRule:        SituationalSuperko,
+	AllowSuicide:  true,
+	ScoringMethod: AreaScoring,
+	Komi:          7.0,
+}
@@ -0,0 +1,273 @@
+package board
+
+import (
+	"container/list"
+
+	"github.com/otrego/clamshell/core/color"
+	"github.com/otrego/clamshell/core/point"
+)
+
+// Result is the outcome of scoring a finished game.
+type Result struct {
+	Black  float64
+	White  float64
+	Winner color.Color
+	Margin float64
+}
+
+// stoneGroup is a maximal set of same-colored, orthogonally connected
+// stones.
+type stoneGroup struct {
+	color  color.Color
+	stones []*point.Point
+}
+
+// Score tallies the result of a finished game under b's ruleset, given
+// the points marked dead. Dead stones are treated as captures and
+// their points as territory; living stones and surrounded territory
+// are counted according to b.Ruleset().ScoringMethod, and Komi is
+// added to White.
+func (b *Board) Score(dead []*point.Point) Result {
+	deadSet := make(map[point.Point]bool, len(dead))
+	for _, pt := range dead {
+		deadSet[*pt] = true
+	}
+
+	size := len(b.board)
+	var livingBlack, livingWhite, deadBlack, deadWhite int
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			pt := point.New(int64(x), int64(y))
+			c := b.colorAt(pt)
+			if deadSet[*pt] {
+				if c == color.Black {
+					deadBlack++
+				} else if c == color.White {
+					deadWhite++
+				}
+				continue
+			}
+			if c == color.Black {
+				livingBlack++
+			} else if c == color.White {
+				livingWhite++
+			}
+		}
+	}
+
+	blackTerritory, whiteTerritory := b.territory(deadSet)
+
+	var black, white float64
+	switch b.ruleset.ScoringMethod {
+	case AreaScoring:
+		black = float64(blackTerritory + livingBlack)
+		white = float64(whiteTerritory + livingWhite)
+	case AGAScoring:
+		black = float64(blackTerritory + livingBlack + b.captures[color.Black] + deadWhite)
+		white = float64(whiteTerritory + livingWhite + b.captures[color.White] + deadBlack)
+	default: // TerritoryScoring
+		black = float64(blackTerritory + b.captures[color.Black] + deadWhite)
+		white = float64(whiteTerritory + b.captures[color.White] + deadBlack)
+	}
+	white += b.ruleset.Komi
+
+	result := Result{Black: black, White: white}
+	if black >= white {
+		result.Winner = color.Black
+		result.Margin = black - white
+	} else {
+		result.Winner = color.White
+		result.Margin = white - black
+	}
+	return result
+}
+
+// territory flood-fills every empty region (treating dead stones as
+// empty) and attributes it to whichever color alone borders it.
+func (b *Board) territory(deadSet map[point.Point]bool) (black, white int) {
+	size := len(b.board)
+	visited := make(map[point.Point]bool)
+
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			pt := point.New(int64(x), int64(y))
+			if visited[*pt] {
+				continue
+			}
+			if b.colorAt(pt) != color.Empty && !deadSet[*pt] {
+				visited[*pt] = true
+				continue
+			}
+
+			regionSize, borders := b.floodEmptyRegion(pt, deadSet, visited)
+			if len(borders) != 1 {
+				continue
+			}
+			for c := range borders {
+				if c == color.Black {
+					black += regionSize
+				} else if c == color.White {
+					white += regionSize
+				}
+			}
+		}
+	}
+	return black, white
+}
+
+// floodEmptyRegion explores the connected region of empty points (dead
+// stones count as empty) starting at pt, returning its size and the
+// set of live colors bordering it.
+func (b *Board) floodEmptyRegion(pt *point.Point, deadSet map[point.Point]bool, visited map[point.Point]bool) (int, map[color.Color]struct{}) {
+	borders := make(map[color.Color]struct{})
+	size := 0
+
+	queue := list.New()
+	queue.PushBack(pt)
+	visited[*pt] = true
+	for queue.Len() > 0 {
+		e := queue.Front()
+		queue.Remove(e)
+		cur := e.Value.(*point.Point)
+		size++
+
+		for _, n := range b.getNeighbors(cur) {
+			if !b.inBounds(n) {
+				continue
+			}
+			c := b.colorAt(n)
+			if c == color.Empty || deadSet[*n] {
+				if !visited[*n] {
+					visited[*n] = true
+					queue.PushBack(n)
+				}
+			} else {
+				borders[c] = struct{}{}
+			}
+		}
+	}
+	return size, borders
+}
+
+// livingGroups returns every maximal same-colored stone group on the
+// board.
+func (b *Board) livingGroups() []stoneGroup {
+	size := len(b.board)
+	visited := make(map[point.Point]bool)
+	var groups []stoneGroup
+
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			pt := point.New(int64(x), int64(y))
+			if visited[*pt] {
+				continue
+			}
+			c := b.colorAt(pt)
+			if c == color.Empty {
+				visited[*pt] = true
+				continue
+			}
+			groups = append(groups, stoneGroup{color: c, stones: b.expandGroup(pt, c, visited)})
+		}
+	}
+	return groups
+}
+
+// expandGroup flood-fills the same-colored group containing pt.
+func (b *Board) expandGroup(pt *point.Point, c color.Color, visited map[point.Point]bool) []*point.Point {
+	var stones []*point.Point
+
+	queue := list.New()
+	queue.PushBack(pt)
+	visited[*pt] = true
+	for queue.Len() > 0 {
+		e := queue.Front()
+		queue.Remove(e)
+		cur := e.Value.(*point.Point)
+		stones = append(stones, cur)
+
+		for _, n := range b.getNeighbors(cur) {
+			if !b.inBounds(n) || visited[*n] {
+				continue
+			}
+			if b.colorAt(n) == c {
+				visited[*n] = true
+				queue.PushBack(n)
+			}
+		}
+	}
+	return stones
+}
+
+// countEyes counts g's simple eyes: empty regions bordered exclusively
+// by g's own color.
+func (b *Board) countEyes(g stoneGroup) int {
+	visited := make(map[point.Point]bool)
+	eyes := 0
+	for _, pt := range g.stones {
+		for _, n := range b.getNeighbors(pt) {
+			if !b.inBounds(n) || visited[*n] || b.colorAt(n) != color.Empty {
+				continue
+			}
+			if b.isSimpleEye(n, g.color, visited) {
+				eyes++
+			}
+		}
+	}
+	return eyes
+}
+
+// isSimpleEye flood-fills the empty region starting at pt, marking it
+// visited, and reports whether every stone bordering the region is c.
+func (b *Board) isSimpleEye(pt *point.Point, c color.Color, visited map[point.Point]bool) bool {
+	pure := true
+
+	queue := list.New()
+	queue.PushBack(pt)
+	visited[*pt] = true
+	for queue.Len() > 0 {
+		e := queue.Front()
+		queue.Remove(e)
+		cur := e.Value.(*point.Point)
+
+		for _, n := range b.getNeighbors(cur) {
+			if !b.inBounds(n) {
+				continue
+			}
+			nc := b.colorAt(n)
+			if nc == color.Empty {
+				if !visited[*n] {
+					visited[*n] = true
+					queue.PushBack(n)
+				}
+			} else if nc != c {
+				pure = false
+			}
+		}
+	}
+	return pure
+}
+
+// DeadStoneDetector suggests which stones are dead in a finished
+// position, so Game.EnterScoring can pre-populate a suggestion for
+// the players to confirm or correct.
+type DeadStoneDetector interface {
+	// Detect returns the points it considers dead on b.
+	Detect(b *Board) []*point.Point
+}
+
+// NaiveDeadStoneDetector marks a group dead if it has fewer than two
+// simple eyes. It's a rough heuristic meant as a starting suggestion,
+// not a substitute for players agreeing on life and death.
+type NaiveDeadStoneDetector struct{}
+
+// Detect implements DeadStoneDetector.
+func (NaiveDeadStoneDetector) Detect(b *Board) []*point.Point {
+	var dead []*point.Point
+	for _, g := range b.livingGroups() {
+		if b.countEyes(g) < 2 {
+			dead = append(dead, g.stones...)
+		}
+	}
+	return dead
+}
@@ -0,0 +1,98 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/otrego/clamshell/core/color"
+	"github.com/otrego/clamshell/core/point"
+)
+
+// enclosedBoard builds a 4x4 board where a ring of Black stones fully
+// encloses a 2x2 empty region, with no White stones at all:
+//
+//	B B B B
+//	B . . B
+//	B . . B
+//	B B B B
+func enclosedBoard(ruleset RulesetOptions) *Board {
+	stones := make(map[point.Point]color.Color)
+	for x := int64(0); x < 4; x++ {
+		stones[*point.New(x, 0)] = color.Black
+		stones[*point.New(x, 3)] = color.Black
+	}
+	stones[*point.New(0, 1)] = color.Black
+	stones[*point.New(3, 1)] = color.Black
+	stones[*point.New(0, 2)] = color.Black
+	stones[*point.New(3, 2)] = color.Black
+	return newTestBoard(4, ruleset, stones)
+}
+
+func TestScore_TerritoryScoring(t *testing.T) {
+	b := enclosedBoard(JapaneseRuleset)
+	result := b.Score(nil)
+
+	if result.Black != 4 {
+		t.Errorf("expected Black territory of 4, got %v", result.Black)
+	}
+	if result.White != JapaneseRuleset.Komi {
+		t.Errorf("expected White to have only komi (%v), got %v", JapaneseRuleset.Komi, result.White)
+	}
+	if result.Winner != color.White {
+		t.Errorf("expected White to win on komi alone, got %v", result.Winner)
+	}
+}
+
+func TestScore_AreaScoring(t *testing.T) {
+	b := enclosedBoard(ChineseRuleset)
+	result := b.Score(nil)
+
+	// 4 points of territory plus the 12 living Black stones on the wall.
+	if result.Black != 16 {
+		t.Errorf("expected Black area of 16, got %v", result.Black)
+	}
+	if result.White != ChineseRuleset.Komi {
+		t.Errorf("expected White to have only komi (%v), got %v", ChineseRuleset.Komi, result.White)
+	}
+	if result.Winner != color.Black {
+		t.Errorf("expected Black to win under area scoring, got %v", result.Winner)
+	}
+}
+
+func TestScore_AGAScoringCountsPrisoners(t *testing.T) {
+	b := enclosedBoard(AGARuleset)
+	b.captures[color.Black] = 3
+
+	result := b.Score(nil)
+
+	// 4 territory + 12 living stones + 3 prisoners.
+	if result.Black != 19 {
+		t.Errorf("expected Black area+prisoners of 19, got %v", result.Black)
+	}
+}
+
+func TestScore_DeadStonesCountAsCapturesAndTerritory(t *testing.T) {
+	stones := make(map[point.Point]color.Color)
+	for x := int64(0); x < 4; x++ {
+		stones[*point.New(x, 0)] = color.Black
+		stones[*point.New(x, 3)] = color.Black
+	}
+	stones[*point.New(0, 1)] = color.Black
+	stones[*point.New(3, 1)] = color.Black
+	stones[*point.New(0, 2)] = color.Black
+	stones[*point.New(3, 2)] = color.Black
+	// A single White stone stranded inside Black's wall.
+	stones[*point.New(1, 1)] = color.White
+	b := newTestBoard(4, JapaneseRuleset, stones)
+
+	result := b.Score([]*point.Point{point.New(1, 1)})
+
+	// The dead White stone's point, plus the rest of the enclosed
+	// interior, all count as Black territory, and the dead stone
+	// itself is counted as a prisoner under territory scoring.
+	if result.Black != 5 {
+		t.Errorf("expected Black to score 5 (4 territory + 1 dead White prisoner), got %v", result.Black)
+	}
+	if result.White != JapaneseRuleset.Komi {
+		t.Errorf("expected White to have only komi, got %v", result.White)
+	}
+}
@@ -0,0 +1,75 @@
+// Package wsproto adapts core/board.BoardEvent values into the JSON
+// messages a websocket-based board UI expects. It only marshals
+// messages; it knows nothing about HTTP or websockets themselves, so
+// it can be reused by whatever transport embeds clamshell.
+package wsproto
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/otrego/clamshell/core/board"
+)
+
+// vertex is a board coordinate in the wire format.
+type vertex struct {
+	X int64 `json:"x"`
+	Y int64 `json:"y"`
+}
+
+// message is the JSON envelope sent to a board UI for a single
+// BoardEvent.
+type message struct {
+	Type     string   `json:"type"`
+	Vertex   *vertex  `json:"vertex,omitempty"`
+	Color    string   `json:"color,omitempty"`
+	Captured []vertex `json:"captured,omitempty"`
+	Reason   string   `json:"reason,omitempty"`
+}
+
+// eventTypeNames maps a board.EventType to its wire "type" string.
+var eventTypeNames = map[board.EventType]string{
+	board.StonePlaced:    "stonePlaced",
+	board.StonesCaptured: "stonesCaptured",
+	board.MoveRejected:   "invalidMove",
+	board.KoSet:          "koSet",
+}
+
+// Marshal converts a BoardEvent into the JSON message a board UI
+// expects, e.g. {"type":"invalidMove","reason":"ko"}.
+func Marshal(e board.BoardEvent) ([]byte, error) {
+	msg := message{Type: eventTypeNames[e.Type]}
+
+	if e.Point != nil {
+		msg.Vertex = &vertex{X: e.Point.X(), Y: e.Point.Y()}
+	}
+	if e.Color != "" {
+		msg.Color = string(e.Color)
+	}
+	if len(e.Captured) > 0 {
+		msg.Captured = make([]vertex, len(e.Captured))
+		for i, pt := range e.Captured {
+			msg.Captured[i] = vertex{X: pt.X(), Y: pt.Y()}
+		}
+	}
+	msg.Reason = e.Reason
+
+	return json.Marshal(msg)
+}
+
+// Serve reads BoardEvents from events and writes each as a JSON
+// message followed by a newline to w, until events is closed or a
+// write fails. It's meant to sit between Board.Subscribe and
+// whatever writes frames to a websocket connection.
+func Serve(events <-chan board.BoardEvent, w io.Writer) error {
+	for e := range events {
+		data, err := Marshal(e)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(append(data, '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
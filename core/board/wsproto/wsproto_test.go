@@ -0,0 +1,90 @@
+package wsproto
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/otrego/clamshell/core/board"
+	"github.com/otrego/clamshell/core/color"
+	"github.com/otrego/clamshell/core/point"
+)
+
+func TestMarshal(t *testing.T) {
+	tests := []struct {
+		name  string
+		event board.BoardEvent
+		want  string
+	}{
+		{
+			name: "StonePlaced",
+			event: board.BoardEvent{
+				Type:  board.StonePlaced,
+				Point: point.New(2, 3),
+				Color: color.Black,
+			},
+			want: `{"type":"stonePlaced","vertex":{"x":2,"y":3},"color":"B"}`,
+		},
+		{
+			name: "StonesCaptured",
+			event: board.BoardEvent{
+				Type:     board.StonesCaptured,
+				Captured: []*point.Point{point.New(0, 0), point.New(1, 0)},
+			},
+			want: `{"type":"stonesCaptured","captured":[{"x":0,"y":0},{"x":1,"y":0}]}`,
+		},
+		{
+			name: "MoveRejected",
+			event: board.BoardEvent{
+				Type:   board.MoveRejected,
+				Point:  point.New(4, 4),
+				Color:  color.White,
+				Reason: "ko",
+			},
+			want: `{"type":"invalidMove","vertex":{"x":4,"y":4},"color":"W","reason":"ko"}`,
+		},
+		{
+			name: "KoSet",
+			event: board.BoardEvent{
+				Type:  board.KoSet,
+				Point: point.New(5, 5),
+			},
+			want: `{"type":"koSet","vertex":{"x":5,"y":5}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Marshal(tt.event)
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal(%+v) = %s, want %s", tt.event, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServe(t *testing.T) {
+	events := make(chan board.BoardEvent, 2)
+	events <- board.BoardEvent{Type: board.StonePlaced, Point: point.New(1, 1), Color: color.Black}
+	events <- board.BoardEvent{Type: board.KoSet, Point: point.New(2, 2)}
+	close(events)
+
+	var out bytes.Buffer
+	if err := Serve(events, &out); err != nil {
+		t.Fatalf("Serve: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(out.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines of output, got %d: %q", len(lines), out.String())
+	}
+	if lines[0] != `{"type":"stonePlaced","vertex":{"x":1,"y":1},"color":"B"}` {
+		t.Errorf("unexpected first line: %s", lines[0])
+	}
+	if lines[1] != `{"type":"koSet","vertex":{"x":2,"y":2}}` {
+		t.Errorf("unexpected second line: %s", lines[1])
+	}
+}
@@ -0,0 +1,42 @@
+package board
+
+import (
+	"math/rand"
+
+	"github.com/otrego/clamshell/core/color"
+)
+
+// colorIndex maps a color.Color to its slot in a zobrist table.
+func colorIndex(c color.Color) int {
+	switch c {
+	case color.Empty:
+		return 0
+	case color.Black:
+		return 1
+	case color.White:
+		return 2
+	default:
+		panic("unrecognized color in colorIndex")
+	}
+}
+
+// sideToMoveSalt is XORed into a position's hash under
+// SituationalSuperko so that the same stone arrangement with a
+// different side to move is not treated as a repeat.
+var sideToMoveSalt = [3]uint64{0, rand.Uint64(), rand.Uint64()}
+
+// newZobristTable builds a [size][size][3]uint64 table of random
+// bitstrings, one per point/color combination, used to incrementally
+// maintain a Board's position hash.
+func newZobristTable(size int) [][][3]uint64 {
+	table := make([][][3]uint64, size)
+	for y := 0; y < size; y++ {
+		table[y] = make([][3]uint64, size)
+		for x := 0; x < size; x++ {
+			for c := 0; c < 3; c++ {
+				table[y][x][c] = rand.Uint64()
+			}
+		}
+	}
+	return table
+}
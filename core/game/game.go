@@ -0,0 +1,276 @@
+// Package game composes core/board.Board into a full match state
+// machine: turn order, players, move history, and lifecycle state.
+package game
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/otrego/clamshell/core/board"
+	"github.com/otrego/clamshell/core/color"
+	"github.com/otrego/clamshell/core/move"
+	"github.com/otrego/clamshell/core/point"
+)
+
+// State is the lifecycle stage of a Game.
+type State int
+
+// The lifecycle states a Game moves through, in order.
+const (
+	Lobby State = iota
+	Playing
+	Scoring
+	Finished
+)
+
+// String returns a human-readable name for the state.
+func (s State) String() string {
+	switch s {
+	case Lobby:
+		return "lobby"
+	case Playing:
+		return "playing"
+	case Scoring:
+		return "scoring"
+	case Finished:
+		return "finished"
+	default:
+		return "unknown"
+	}
+}
+
+// historyEntry records one action taken during a game, enough to
+// replay the game from scratch for Undo.
+type historyEntry struct {
+	color  color.Color
+	point  *point.Point
+	pass   bool
+	resign bool
+}
+
+// Game is a full match: a Board plus the Players, turn order, and
+// history needed to referee a game of Go from lobby to finish.
+type Game struct {
+	// Board is the underlying board. It remains fully usable on its own;
+	// Game only adds turn order, players, and history on top of it.
+	Board *board.Board
+
+	size              int
+	ruleset           board.RulesetOptions
+	players           map[color.Color]*Player
+	turn              color.Color
+	state             State
+	history           []historyEntry
+	consecutivePasses int
+	resigned          color.Color
+	suggestedDead     []*point.Point
+}
+
+// NewGame creates a new size x size Game in the Lobby state, scored
+// under Japanese rules.
+func NewGame(size int) *Game {
+	return NewGameWithRuleset(size, board.JapaneseRuleset)
+}
+
+// NewGameWithRuleset creates a new size x size Game in the Lobby
+// state, enforcing and scoring under ruleset.
+func NewGameWithRuleset(size int, ruleset board.RulesetOptions) *Game {
+	return &Game{
+		Board:   board.NewBoardWithRuleset(size, ruleset),
+		size:    size,
+		ruleset: ruleset,
+		players: map[color.Color]*Player{
+			color.Black: NewPlayer("", color.Black, 0),
+			color.White: NewPlayer("", color.White, 0),
+		},
+		turn:  color.Black,
+		state: Lobby,
+	}
+}
+
+// Size returns the board's side length.
+func (g *Game) Size() int {
+	return g.size
+}
+
+// Ruleset returns the ruleset this game enforces and scores under.
+func (g *Game) Ruleset() board.RulesetOptions {
+	return g.ruleset
+}
+
+// SetKomi updates the komi used when scoring the game, including by
+// any Board a later Undo or boardsize change reconstructs.
+func (g *Game) SetKomi(komi float64) {
+	g.ruleset.Komi = komi
+	g.Board.SetKomi(komi)
+}
+
+// Player returns the Player seated as c.
+func (g *Game) Player(c color.Color) *Player {
+	return g.players[c]
+}
+
+// Turn returns the color whose move it currently is.
+func (g *Game) Turn() color.Color {
+	return g.turn
+}
+
+// State returns the Game's current lifecycle state.
+func (g *Game) State() State {
+	return g.state
+}
+
+// Play places a stone for color c at pt, enforcing turn order and
+// delegating the legality of the move itself to Board.PlaceStone.
+func (g *Game) Play(c color.Color, pt *point.Point) error {
+	if g.state == Lobby {
+		g.state = Playing
+	}
+	if g.state != Playing {
+		return fmt.Errorf("game is not in progress (state is %v)", g.state)
+	}
+	if c != g.turn {
+		return fmt.Errorf("it is %v's turn, not %v's", g.turn, c)
+	}
+
+	captured, err := g.Board.PlaceStone(move.NewMove(c, pt))
+	if err != nil {
+		return err
+	}
+
+	capturer := c
+	for _, cp := range captured {
+		if *cp == *pt {
+			// Suicide: the removed stones are c's own.
+			capturer = oppositeColor(c)
+			break
+		}
+	}
+	g.players[capturer].Captures += len(captured)
+	g.history = append(g.history, historyEntry{color: c, point: pt})
+	g.consecutivePasses = 0
+	g.turn = oppositeColor(c)
+	return nil
+}
+
+// Pass passes the turn for color c. The game ends once both players
+// have passed in succession.
+func (g *Game) Pass(c color.Color) error {
+	if g.state == Lobby {
+		g.state = Playing
+	}
+	if g.state != Playing {
+		return fmt.Errorf("game is not in progress (state is %v)", g.state)
+	}
+	if c != g.turn {
+		return fmt.Errorf("it is %v's turn, not %v's", g.turn, c)
+	}
+
+	g.history = append(g.history, historyEntry{color: c, pass: true})
+	g.consecutivePasses++
+	g.turn = oppositeColor(c)
+	if g.consecutivePasses >= 2 {
+		g.state = Finished
+	}
+	return nil
+}
+
+// Resign ends the game immediately with c as the resigning player.
+func (g *Game) Resign(c color.Color) error {
+	if g.state == Lobby {
+		g.state = Playing
+	}
+	if g.state != Playing {
+		return fmt.Errorf("game is not in progress (state is %v)", g.state)
+	}
+	g.resigned = c
+	g.state = Finished
+	g.history = append(g.history, historyEntry{color: c, resign: true})
+	return nil
+}
+
+// Resigned returns the color that resigned, or color.Empty if no one
+// has.
+func (g *Game) Resigned() color.Color {
+	return g.resigned
+}
+
+// EnterScoring moves the game into the Scoring state and returns a
+// suggested set of dead stones from detector, which the players then
+// confirm or correct before calling Score. A nil detector falls back
+// to board.NaiveDeadStoneDetector.
+func (g *Game) EnterScoring(detector board.DeadStoneDetector) []*point.Point {
+	if detector == nil {
+		detector = board.NaiveDeadStoneDetector{}
+	}
+	g.state = Scoring
+	g.suggestedDead = detector.Detect(g.Board)
+	return g.suggestedDead
+}
+
+// Score tallies the game's result given the final set of dead stones,
+// as agreed by the players (see EnterScoring for a starting
+// suggestion).
+func (g *Game) Score(dead []*point.Point) board.Result {
+	return g.Board.Score(dead)
+}
+
+// Undo reverts the most recent Play/Pass/Resign by replaying the
+// remaining history onto a fresh Board; Board itself has no notion of
+// undo.
+func (g *Game) Undo() error {
+	if len(g.history) == 0 {
+		return fmt.Errorf("no moves to undo")
+	}
+	replay := g.history[:len(g.history)-1]
+
+	g.Board = board.NewBoardWithRuleset(g.size, g.ruleset)
+	g.players[color.Black].Captures = 0
+	g.players[color.White].Captures = 0
+	g.turn = color.Black
+	g.consecutivePasses = 0
+	g.state = Playing
+	g.resigned = color.Empty
+	g.history = nil
+
+	for _, entry := range replay {
+		switch {
+		case entry.resign:
+			if err := g.Resign(entry.color); err != nil {
+				return err
+			}
+		case entry.pass:
+			if err := g.Pass(entry.color); err != nil {
+				return err
+			}
+		default:
+			if err := g.Play(entry.color, entry.point); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// oppositeColor returns the other player's color.
+func oppositeColor(c color.Color) color.Color {
+	if c == color.Black {
+		return color.White
+	}
+	return color.Black
+}
+
+// String renders the board followed by each player's captures and
+// whose turn it is.
+func (g *Game) String() string {
+	var sb strings.Builder
+	sb.WriteString(g.Board.String())
+	sb.WriteString(fmt.Sprintf("\n\nBlack captures: %d  White captures: %d\n",
+		g.players[color.Black].Captures, g.players[color.White].Captures))
+	if g.state == Finished {
+		sb.WriteString(fmt.Sprintf("Game finished (%v)\n", g.state))
+	} else {
+		sb.WriteString(fmt.Sprintf("Turn: %v\n", g.turn))
+	}
+	return sb.String()
+}
@@ -0,0 +1,106 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/otrego/clamshell/core/color"
+	"github.com/otrego/clamshell/core/point"
+)
+
+func TestGame_PlayEnforcesTurnOrder(t *testing.T) {
+	g := NewGame(9)
+
+	if err := g.Play(color.White, point.New(0, 0)); err == nil {
+		t.Fatal("expected an error playing out of turn")
+	}
+	if err := g.Play(color.Black, point.New(0, 0)); err != nil {
+		t.Fatalf("unexpected error on Black's turn: %v", err)
+	}
+	if g.Turn() != color.White {
+		t.Fatalf("expected it to be White's turn, got %v", g.Turn())
+	}
+}
+
+func TestGame_PassTwiceFinishesTheGame(t *testing.T) {
+	g := NewGame(9)
+
+	if err := g.Pass(color.Black); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if g.State() != Playing {
+		t.Fatalf("expected state Playing after one pass, got %v", g.State())
+	}
+	if err := g.Pass(color.White); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if g.State() != Finished {
+		t.Fatalf("expected state Finished after two passes, got %v", g.State())
+	}
+}
+
+func TestGame_ResignFinishesTheGame(t *testing.T) {
+	g := NewGame(9)
+
+	if err := g.Resign(color.White); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if g.State() != Finished {
+		t.Fatalf("expected state Finished after resignation, got %v", g.State())
+	}
+	if g.Resigned() != color.White {
+		t.Fatalf("expected White to be recorded as resigned, got %v", g.Resigned())
+	}
+}
+
+func TestGame_UndoReversesResignation(t *testing.T) {
+	g := NewGame(9)
+	if err := g.Play(color.Black, point.New(0, 0)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := g.Resign(color.White); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := g.Undo(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if g.State() != Playing {
+		t.Fatalf("expected state Playing after undoing a resignation, got %v", g.State())
+	}
+	if g.Resigned() != color.Empty {
+		t.Fatalf("expected no resignation recorded after undo, got %v", g.Resigned())
+	}
+	if g.Turn() != color.White {
+		t.Fatalf("expected it to still be White's turn, got %v", g.Turn())
+	}
+}
+
+func TestGame_UndoReversesPlay(t *testing.T) {
+	g := NewGame(9)
+	if err := g.Play(color.Black, point.New(0, 0)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := g.Play(color.White, point.New(1, 0)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := g.Undo(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if g.Turn() != color.White {
+		t.Fatalf("expected it to be White's turn again, got %v", g.Turn())
+	}
+	if err := g.Play(color.White, point.New(1, 0)); err != nil {
+		t.Fatalf("expected White's move to be replayable: %v", err)
+	}
+}
+
+func TestGame_SetKomiAppliesToScoring(t *testing.T) {
+	g := NewGame(9)
+	g.SetKomi(42)
+
+	result := g.Score(nil)
+	if result.White != 42 {
+		t.Fatalf("expected White's score to reflect the new komi of 42, got %v", result.White)
+	}
+}
@@ -0,0 +1,25 @@
+package game
+
+import (
+	"time"
+
+	"github.com/otrego/clamshell/core/color"
+)
+
+// Player holds the per-seat state for one side of a Game: who they are,
+// how many stones they've captured, and how much time they have left.
+type Player struct {
+	Name     string
+	Color    color.Color
+	Captures int
+	TimeLeft time.Duration
+}
+
+// NewPlayer creates a Player for the given color.
+func NewPlayer(name string, c color.Color, timeLeft time.Duration) *Player {
+	return &Player{
+		Name:     name,
+		Color:    c,
+		TimeLeft: timeLeft,
+	}
+}
@@ -0,0 +1,314 @@
+// Package gtp speaks the Go Text Protocol against a *game.Game, so
+// clamshell can act as an engine or referee for GTP frontends like
+// GoGui, KGS bots, or sabaki.
+package gtp
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/otrego/clamshell/core/board"
+	"github.com/otrego/clamshell/core/color"
+	"github.com/otrego/clamshell/core/game"
+	"github.com/otrego/clamshell/core/point"
+)
+
+// knownCommands are the commands Engine.Serve understands, in the
+// order list_commands reports them.
+var knownCommands = []string{
+	"protocol_version",
+	"name",
+	"version",
+	"boardsize",
+	"clear_board",
+	"komi",
+	"play",
+	"genmove",
+	"undo",
+	"showboard",
+	"list_commands",
+	"known_command",
+	"final_score",
+	"quit",
+}
+
+// errIllegalMove is the sentinel dispatch returns for play/genmove
+// failures that came from a board.MoveViolation, so Serve can report
+// the GTP-standard "illegal move" rather than clamshell's own message.
+var errIllegalMove = errors.New("illegal move")
+
+// MoveGenerator picks a move for genmove. The default, PassGenerator,
+// always passes.
+type MoveGenerator interface {
+	// GenMove returns the point to play for c, or pass=true to pass.
+	GenMove(g *game.Game, c color.Color) (pt *point.Point, pass bool)
+}
+
+// PassGenerator is a MoveGenerator that always passes.
+type PassGenerator struct{}
+
+// GenMove implements MoveGenerator.
+func (PassGenerator) GenMove(*game.Game, color.Color) (*point.Point, bool) {
+	return nil, true
+}
+
+// Engine serves GTP commands against a Game. Its zero value is not
+// usable; construct one with NewEngine.
+type Engine struct {
+	Game          *game.Game
+	MoveGenerator MoveGenerator
+}
+
+// NewEngine creates an Engine for g, defaulting genmove to PassGenerator.
+func NewEngine(g *game.Game) *Engine {
+	return &Engine{Game: g, MoveGenerator: PassGenerator{}}
+}
+
+// Serve speaks GTP over r/w against g, using PassGenerator for
+// genmove. For a custom MoveGenerator, construct an Engine directly
+// and call its Serve method instead.
+func Serve(r io.Reader, w io.Writer, g *game.Game) error {
+	return NewEngine(g).Serve(r, w)
+}
+
+// Serve reads GTP commands from r, one per line, and writes responses
+// to w until r is exhausted or a "quit" command is received.
+func (e *Engine) Serve(r io.Reader, w io.Writer) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		id, cmd, args := parseLine(line)
+		result, err := e.dispatch(cmd, args)
+		writeResponse(w, id, result, err)
+		if cmd == "quit" {
+			return nil
+		}
+	}
+	return scanner.Err()
+}
+
+// parseLine splits a GTP command line into its optional id, command
+// name, and arguments.
+func parseLine(line string) (id, cmd string, args []string) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return "", "", nil
+	}
+	i := 0
+	if _, err := strconv.Atoi(fields[0]); err == nil {
+		id = fields[0]
+		i = 1
+	}
+	if i >= len(fields) {
+		return id, "", nil
+	}
+	return id, fields[i], fields[i+1:]
+}
+
+// writeResponse writes a GTP response line for the result of one
+// command, per the "=id result" / "?id message" status-line format.
+func writeResponse(w io.Writer, id, result string, err error) {
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, errIllegalMove) {
+			msg = "illegal move"
+		}
+		fmt.Fprintf(w, "?%s %s\n\n", id, msg)
+		return
+	}
+	fmt.Fprintf(w, "=%s %s\n\n", id, result)
+}
+
+// dispatch runs a single GTP command and returns its result string.
+func (e *Engine) dispatch(cmd string, args []string) (string, error) {
+	switch cmd {
+	case "protocol_version":
+		return "2", nil
+	case "name":
+		return "clamshell", nil
+	case "version":
+		return "0.1", nil
+	case "list_commands":
+		return strings.Join(knownCommands, "\n"), nil
+	case "known_command":
+		if len(args) != 1 {
+			return "", fmt.Errorf("known_command requires exactly 1 argument")
+		}
+		for _, c := range knownCommands {
+			if c == args[0] {
+				return "true", nil
+			}
+		}
+		return "false", nil
+	case "boardsize":
+		return e.boardsize(args)
+	case "clear_board":
+		e.Game = game.NewGameWithRuleset(e.Game.Size(), e.Game.Ruleset())
+		return "", nil
+	case "komi":
+		if len(args) != 1 {
+			return "", fmt.Errorf("komi requires exactly 1 argument")
+		}
+		komi, err := strconv.ParseFloat(args[0], 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid komi %q", args[0])
+		}
+		e.Game.SetKomi(komi)
+		return "", nil
+	case "play":
+		return e.play(args)
+	case "genmove":
+		return e.genmove(args)
+	case "undo":
+		if err := e.Game.Undo(); err != nil {
+			return "", err
+		}
+		return "", nil
+	case "showboard":
+		return "\n" + e.Game.Board.String(), nil
+	case "final_score":
+		return e.finalScore(), nil
+	case "quit":
+		return "", nil
+	default:
+		return "", fmt.Errorf("unknown command %q", cmd)
+	}
+}
+
+func (e *Engine) boardsize(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("boardsize requires exactly 1 argument")
+	}
+	size, err := strconv.Atoi(args[0])
+	if err != nil || size <= 0 {
+		return "", fmt.Errorf("invalid board size %q", args[0])
+	}
+	e.Game = game.NewGameWithRuleset(size, e.Game.Ruleset())
+	return "", nil
+}
+
+func (e *Engine) play(args []string) (string, error) {
+	if len(args) != 2 {
+		return "", fmt.Errorf("play requires exactly 2 arguments")
+	}
+	c, err := parseColor(args[0])
+	if err != nil {
+		return "", err
+	}
+	pt, pass, err := parseVertex(args[1], e.Game.Size())
+	if err != nil {
+		return "", err
+	}
+
+	if pass {
+		err = e.Game.Pass(c)
+	} else {
+		err = e.Game.Play(c, pt)
+	}
+	if err != nil {
+		var violation board.MoveViolation
+		if errors.As(err, &violation) {
+			return "", errIllegalMove
+		}
+		return "", err
+	}
+	return "", nil
+}
+
+func (e *Engine) genmove(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("genmove requires exactly 1 argument")
+	}
+	c, err := parseColor(args[0])
+	if err != nil {
+		return "", err
+	}
+
+	pt, pass := e.MoveGenerator.GenMove(e.Game, c)
+	if pass {
+		if err := e.Game.Pass(c); err != nil {
+			return "", err
+		}
+		return "pass", nil
+	}
+	if err := e.Game.Play(c, pt); err != nil {
+		var violation board.MoveViolation
+		if errors.As(err, &violation) {
+			return "", errIllegalMove
+		}
+		return "", err
+	}
+	return vertexString(pt, e.Game.Size()), nil
+}
+
+func (e *Engine) finalScore() string {
+	if resigned := e.Game.Resigned(); resigned != color.Empty {
+		return fmt.Sprintf("%s+Resign", string(oppositeColor(resigned)))
+	}
+	dead := e.Game.EnterScoring(nil)
+	result := e.Game.Score(dead)
+	return fmt.Sprintf("%s+%.1f", string(result.Winner), result.Margin)
+}
+
+// parseColor parses a GTP color argument ("b", "black", "w", "white",
+// case-insensitive).
+func parseColor(s string) (color.Color, error) {
+	switch strings.ToLower(s) {
+	case "b", "black":
+		return color.Black, nil
+	case "w", "white":
+		return color.White, nil
+	default:
+		return color.Empty, fmt.Errorf("invalid color %q", s)
+	}
+}
+
+// oppositeColor returns the other player's color.
+func oppositeColor(c color.Color) color.Color {
+	if c == color.Black {
+		return color.White
+	}
+	return color.Black
+}
+
+// parseVertex parses a GTP vertex ("D4", or "pass") into a board
+// point. GTP columns skip "I" and rows count from 1 at the bottom.
+func parseVertex(s string, size int) (pt *point.Point, pass bool, err error) {
+	if strings.EqualFold(s, "pass") {
+		return nil, true, nil
+	}
+	s = strings.ToUpper(s)
+	if len(s) < 2 {
+		return nil, false, fmt.Errorf("invalid vertex %q", s)
+	}
+
+	if s[0] == 'I' {
+		return nil, false, fmt.Errorf("invalid vertex %q: column I is skipped", s)
+	}
+	col := int(s[0] - 'A')
+	if s[0] > 'I' {
+		col--
+	}
+	row, err := strconv.Atoi(s[1:])
+	if err != nil || row < 1 || row > size || col < 0 || col >= size {
+		return nil, false, fmt.Errorf("invalid vertex %q", s)
+	}
+	return point.New(int64(col), int64(size-row)), false, nil
+}
+
+// vertexString renders pt as a GTP vertex for the given board size.
+func vertexString(pt *point.Point, size int) string {
+	letter := byte('A' + pt.X())
+	if letter >= 'I' {
+		letter++
+	}
+	return fmt.Sprintf("%c%d", letter, size-int(pt.Y()))
+}
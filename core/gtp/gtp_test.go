@@ -0,0 +1,98 @@
+package gtp
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/otrego/clamshell/core/game"
+)
+
+func serve(t *testing.T, e *Engine, commands string) []string {
+	t.Helper()
+	var out bytes.Buffer
+	if err := e.Serve(strings.NewReader(commands), &out); err != nil {
+		t.Fatalf("Serve: %v", err)
+	}
+	var lines []string
+	for _, line := range strings.Split(strings.TrimRight(out.String(), "\n"), "\n\n") {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+func TestEngine_ProtocolAndName(t *testing.T) {
+	e := NewEngine(game.NewGame(9))
+	lines := serve(t, e, "1 protocol_version\n2 name\n")
+
+	if lines[0] != "=1 2" {
+		t.Errorf("expected protocol_version response \"=1 2\", got %q", lines[0])
+	}
+	if lines[1] != "=2 clamshell" {
+		t.Errorf("expected name response \"=2 clamshell\", got %q", lines[1])
+	}
+}
+
+func TestEngine_PlayAndIllegalMove(t *testing.T) {
+	e := NewEngine(game.NewGame(9))
+	lines := serve(t, e, "1 play black D4\n2 play white D4\n")
+
+	if lines[0] != "=1 " {
+		t.Errorf("expected the first play to succeed, got %q", lines[0])
+	}
+	if lines[1] != "?2 illegal move" {
+		t.Errorf("expected playing on an occupied point to report \"illegal move\", got %q", lines[1])
+	}
+}
+
+func TestEngine_KomiIsWiredIntoScoring(t *testing.T) {
+	e := NewEngine(game.NewGame(9))
+	lines := serve(t, e, "1 komi 42\n2 final_score\n")
+
+	if lines[0] != "=1 " {
+		t.Errorf("expected komi to be accepted, got %q", lines[0])
+	}
+	if lines[1] != "=2 W+42.0" {
+		t.Errorf("expected final_score to reflect the new komi, got %q", lines[1])
+	}
+}
+
+func TestEngine_UnknownCommand(t *testing.T) {
+	e := NewEngine(game.NewGame(9))
+	lines := serve(t, e, "1 frobnicate\n")
+
+	if !strings.HasPrefix(lines[0], "?1 ") {
+		t.Errorf("expected an error response for an unknown command, got %q", lines[0])
+	}
+}
+
+func TestParseVertex_RejectsSkippedColumnI(t *testing.T) {
+	if _, _, err := parseVertex("I5", 9); err == nil {
+		t.Fatal("expected column I to be rejected")
+	}
+}
+
+func TestParseVertex_RoundTripsWithVertexString(t *testing.T) {
+	pt, pass, err := parseVertex("J5", 9)
+	if err != nil || pass {
+		t.Fatalf("unexpected result parsing J5: pt=%v pass=%v err=%v", pt, pass, err)
+	}
+	if got := vertexString(pt, 9); got != "J5" {
+		t.Errorf("expected vertexString to round-trip to J5, got %q", got)
+	}
+}
+
+func TestParseVertex_Pass(t *testing.T) {
+	pt, pass, err := parseVertex("pass", 9)
+	if err != nil || !pass || pt != nil {
+		t.Fatalf("expected pass=true, pt=nil, err=nil; got pt=%v pass=%v err=%v", pt, pass, err)
+	}
+}
+
+func TestParseVertex_OutOfRangeRow(t *testing.T) {
+	if _, _, err := parseVertex("D10", 9); err == nil {
+		t.Fatal("expected a row beyond the board size to be rejected")
+	}
+}